@@ -0,0 +1,75 @@
+// Package importer provides a shared Terraform import ID format for
+// resources in this provider that can be scoped to a subuser, so that every
+// resource parses and validates import IDs the same way instead of each
+// resource file reimplementing it.
+package importer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ParseSubuserID splits a raw import ID of the form "[subuser/]resourceID"
+// into its subuser and resourceID parts. The subuser segment is optional; if
+// absent, subuser is returned empty. resourceID must be non-empty and must
+// not contain whitespace.
+func ParseSubuserID(rawID string) (subuser, resourceID string, err error) {
+	subuser, resourceID, found := strings.Cut(rawID, "/")
+	if !found {
+		resourceID = subuser
+		subuser = ""
+	}
+
+	if resourceID == "" {
+		return "", "", fmt.Errorf("import ID %q: resource ID must not be empty", rawID)
+	}
+
+	if strings.IndexFunc(resourceID, unicode.IsSpace) >= 0 {
+		return "", "", fmt.Errorf("import ID %q: resource ID must not contain whitespace", rawID)
+	}
+
+	return subuser, resourceID, nil
+}
+
+// SubuserStateContext builds a schema.StateContextFunc that parses import
+// IDs of the form "[subuser/]resourceID", sets subuserField in state before
+// the first Read, and sets the resource ID to the parsed resourceID.
+func SubuserStateContext(subuserField string) schema.StateContextFunc {
+	return func(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+		subuser, resourceID, err := ParseSubuserID(d.Id())
+		if err != nil {
+			return nil, err
+		}
+
+		if subuser != "" {
+			if err := d.Set(subuserField, subuser); err != nil {
+				return nil, err
+			}
+		}
+
+		d.SetId(resourceID)
+
+		return []*schema.ResourceData{d}, nil
+	}
+}
+
+// StateContext builds a schema.StateContextFunc for resources with no
+// subuser-scoped field of their own: it still validates the resource ID
+// shape and strips an optional "subuser/" prefix, so the error messages
+// match SubuserStateContext even though the subuser is discarded.
+func StateContext() schema.StateContextFunc {
+	return func(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+		_, resourceID, err := ParseSubuserID(d.Id())
+		if err != nil {
+			return nil, err
+		}
+
+		d.SetId(resourceID)
+
+		return []*schema.ResourceData{d}, nil
+	}
+}
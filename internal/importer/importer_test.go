@@ -0,0 +1,105 @@
+package importer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestParseSubuserID(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		rawID        string
+		wantSubuser  string
+		wantResource string
+		wantErr      bool
+	}{
+		{name: "subuser and id", rawID: "subuser/apiKeyID", wantSubuser: "subuser", wantResource: "apiKeyID"},
+		{name: "id only", rawID: "apiKeyID", wantSubuser: "", wantResource: "apiKeyID"},
+		{name: "empty", rawID: "", wantErr: true},
+		{name: "empty resource after slash", rawID: "subuser/", wantErr: true},
+		{name: "whitespace in resource", rawID: "subuser/api Key ID", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			subuser, resourceID, err := ParseSubuserID(tt.rawID)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("ParseSubuserID(%q): expected an error, got none", tt.rawID)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("ParseSubuserID(%q): unexpected error: %s", tt.rawID, err)
+			}
+
+			if subuser != tt.wantSubuser || resourceID != tt.wantResource {
+				t.Fatalf("ParseSubuserID(%q) = (%q, %q), want (%q, %q)", tt.rawID, subuser, resourceID, tt.wantSubuser, tt.wantResource)
+			}
+		})
+	}
+}
+
+func TestSubuserStateContext(t *testing.T) {
+	t.Parallel()
+
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"sub_user_on_behalf_of": {Type: schema.TypeString, Optional: true},
+		},
+	}
+
+	d := r.Data(&terraform.InstanceState{ID: "subuser/apiKeyID"})
+
+	results, err := SubuserStateContext("sub_user_on_behalf_of")(context.Background(), d, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got := results[0].Id(); got != "apiKeyID" {
+		t.Fatalf("got ID %q, want %q", got, "apiKeyID")
+	}
+
+	if got := results[0].Get("sub_user_on_behalf_of").(string); got != "subuser" {
+		t.Fatalf("got sub_user_on_behalf_of %q, want %q", got, "subuser")
+	}
+
+	d = r.Data(&terraform.InstanceState{ID: "apiKeyID"})
+
+	if _, err := SubuserStateContext("sub_user_on_behalf_of")(context.Background(), d, nil); err != nil {
+		t.Fatalf("unexpected error for a bare ID: %s", err)
+	}
+
+	d = r.Data(&terraform.InstanceState{ID: ""})
+
+	if _, err := SubuserStateContext("sub_user_on_behalf_of")(context.Background(), d, nil); err == nil {
+		t.Fatal("expected an error for an empty ID")
+	}
+}
+
+func TestStateContext(t *testing.T) {
+	t.Parallel()
+
+	r := &schema.Resource{Schema: map[string]*schema.Schema{}}
+
+	d := r.Data(&terraform.InstanceState{ID: "subuser/teamID"})
+
+	results, err := StateContext()(context.Background(), d, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got := results[0].Id(); got != "teamID" {
+		t.Fatalf("got ID %q, want %q", got, "teamID")
+	}
+}
@@ -0,0 +1,77 @@
+package sdk
+
+import "net/http"
+
+// APIKey represents a SendGrid API Key.
+type APIKey struct {
+	ID               string   `json:"api_key_id,omitempty"`
+	APIKey           string   `json:"api_key,omitempty"`
+	Name             string   `json:"name,omitempty"`
+	Scopes           []string `json:"scopes,omitempty"`
+	CreatedAt        string   `json:"created_at,omitempty"`
+	ExpiresAt        string   `json:"expires_at,omitempty"`
+	ServiceAccountID string   `json:"service_account_id,omitempty"`
+	TeamIDs          []string `json:"team_ids,omitempty"`
+}
+
+// CreateAPIKey creates an API Key with the given name and scopes. If
+// expiryTime is non-empty, the key is created with that RFC3339 timestamp
+// as its hard expiration. If serviceAccountID is non-empty, the key is
+// registered as attached to that service account so that the service
+// account's ReadServiceAccount/UpdateServiceAccount calls can report and
+// update it.
+func (c *Client) CreateAPIKey(name string, scopes []string, expiryTime, serviceAccountID string) (*APIKey, RequestError) {
+	body := map[string]interface{}{
+		"name":   name,
+		"scopes": scopes,
+	}
+
+	if expiryTime != "" {
+		body["expires_at"] = expiryTime
+	}
+
+	if serviceAccountID != "" {
+		body["service_account_id"] = serviceAccountID
+	}
+
+	var apiKey APIKey
+
+	err := c.request(http.MethodPost, "/v3/api_keys", body, &apiKey)
+
+	return &apiKey, err
+}
+
+// ReadAPIKey fetches an API Key by ID.
+func (c *Client) ReadAPIKey(id string) (*APIKey, RequestError) {
+	var apiKey APIKey
+
+	err := c.request(http.MethodGet, "/v3/api_keys/"+id, nil, &apiKey)
+
+	return &apiKey, err
+}
+
+// UpdateAPIKey updates an API Key's name and scopes, and its attached
+// service account when serviceAccountID is non-empty.
+func (c *Client) UpdateAPIKey(id, name string, scopes []string, serviceAccountID string) (*APIKey, RequestError) {
+	body := map[string]interface{}{
+		"name":   name,
+		"scopes": scopes,
+	}
+
+	if serviceAccountID != "" {
+		body["service_account_id"] = serviceAccountID
+	}
+
+	var apiKey APIKey
+
+	err := c.request(http.MethodPut, "/v3/api_keys/"+id, body, &apiKey)
+
+	return &apiKey, err
+}
+
+// DeleteAPIKey deletes an API Key by ID.
+func (c *Client) DeleteAPIKey(id string) (*APIKey, RequestError) {
+	err := c.request(http.MethodDelete, "/v3/api_keys/"+id, nil, nil)
+
+	return nil, err
+}
@@ -0,0 +1,118 @@
+// Package sdk is a thin client for the subset of the SendGrid v3 API that
+// this provider needs.
+package sdk
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	defaultHost            = "https://api.sendgrid.com"
+	rateLimitRetryInterval = 2 * time.Second
+)
+
+// Client is a thin wrapper around the SendGrid v3 API.
+type Client struct {
+	APIKey     string
+	OnBehalfOf string
+	Host       string
+	HTTPClient *http.Client
+}
+
+// NewClient returns a Client authenticated with apiKey.
+func NewClient(apiKey string) *Client {
+	return &Client{
+		APIKey:     apiKey,
+		Host:       defaultHost,
+		HTTPClient: http.DefaultClient,
+	}
+}
+
+// RequestError wraps the outcome of a single SendGrid API call.
+type RequestError struct {
+	StatusCode int
+	Err        error
+}
+
+// RetryOnRateLimit invokes f, retrying every rateLimitRetryInterval while the
+// SendGrid API reports HTTP 429, until ctx is done.
+func RetryOnRateLimit(ctx context.Context, _ *schema.ResourceData, f func() (interface{}, RequestError)) (interface{}, error) {
+	for {
+		result, err := f()
+		if err.StatusCode != http.StatusTooManyRequests {
+			return result, err.Err
+		}
+
+		select {
+		case <-ctx.Done():
+			return result, ctx.Err()
+		case <-time.After(rateLimitRetryInterval):
+		}
+	}
+}
+
+func (c *Client) request(method, path string, body, out interface{}) RequestError {
+	host := c.Host
+	if host == "" {
+		host = defaultHost
+	}
+
+	var reqBody io.Reader
+
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return RequestError{Err: err}
+		}
+
+		reqBody = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequest(method, host+path, reqBody)
+	if err != nil {
+		return RequestError{Err: err}
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.APIKey)
+	req.Header.Set("Content-Type", "application/json")
+
+	if c.OnBehalfOf != "" {
+		req.Header.Set("On-Behalf-Of", c.OnBehalfOf)
+	}
+
+	httpClient := c.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return RequestError{Err: err}
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		return RequestError{
+			StatusCode: resp.StatusCode,
+			Err:        fmt.Errorf("sendgrid: unexpected status %d from %s %s", resp.StatusCode, method, path),
+		}
+	}
+
+	if out == nil {
+		return RequestError{StatusCode: resp.StatusCode}
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return RequestError{StatusCode: resp.StatusCode, Err: err}
+	}
+
+	return RequestError{StatusCode: resp.StatusCode}
+}
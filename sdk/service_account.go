@@ -0,0 +1,60 @@
+package sdk
+
+import "net/http"
+
+// ServiceAccount represents a role template: a named set of scopes that one
+// or more API Keys can reference instead of declaring their own scopes.
+// AttachedAPIKeyIDs is reported by the API and reflects every API Key whose
+// service_account_id points at this service account.
+type ServiceAccount struct {
+	ID                string   `json:"id,omitempty"`
+	Name              string   `json:"name,omitempty"`
+	Scopes            []string `json:"scopes,omitempty"`
+	AttachedAPIKeyIDs []string `json:"attached_api_key_ids,omitempty"`
+}
+
+// CreateServiceAccount creates a service account with the given name and
+// scopes.
+func (c *Client) CreateServiceAccount(name string, scopes []string) (*ServiceAccount, RequestError) {
+	body := map[string]interface{}{
+		"name":   name,
+		"scopes": scopes,
+	}
+
+	var serviceAccount ServiceAccount
+
+	err := c.request(http.MethodPost, "/v3/service_accounts", body, &serviceAccount)
+
+	return &serviceAccount, err
+}
+
+// ReadServiceAccount fetches a service account by ID, including the IDs of
+// every API Key currently attached to it.
+func (c *Client) ReadServiceAccount(id string) (*ServiceAccount, RequestError) {
+	var serviceAccount ServiceAccount
+
+	err := c.request(http.MethodGet, "/v3/service_accounts/"+id, nil, &serviceAccount)
+
+	return &serviceAccount, err
+}
+
+// UpdateServiceAccount updates a service account's name and scopes. It does
+// not itself update attached API Keys; callers propagate the new scopes to
+// each ID in the returned AttachedAPIKeyIDs via UpdateAPIKey.
+func (c *Client) UpdateServiceAccount(id, name string, scopes []string) (*ServiceAccount, RequestError) {
+	body := map[string]interface{}{
+		"name":   name,
+		"scopes": scopes,
+	}
+
+	var serviceAccount ServiceAccount
+
+	err := c.request(http.MethodPatch, "/v3/service_accounts/"+id, body, &serviceAccount)
+
+	return &serviceAccount, err
+}
+
+// DeleteServiceAccount deletes a service account by ID.
+func (c *Client) DeleteServiceAccount(id string) RequestError {
+	return c.request(http.MethodDelete, "/v3/service_accounts/"+id, nil, nil)
+}
@@ -0,0 +1,82 @@
+package sdk
+
+import "net/http"
+
+// Team represents a SendGrid Teammates/subuser grouping used to bind API
+// Keys together for permission grouping.
+type Team struct {
+	ID          string   `json:"id,omitempty"`
+	Name        string   `json:"name,omitempty"`
+	Teammates   []string `json:"teammates,omitempty"`
+	Permissions []string `json:"permissions,omitempty"`
+}
+
+// CreateTeam creates a team with the given name and initial teammates.
+func (c *Client) CreateTeam(name string, teammates []string) (*Team, RequestError) {
+	body := map[string]interface{}{
+		"name":      name,
+		"teammates": teammates,
+	}
+
+	var team Team
+
+	err := c.request(http.MethodPost, "/v3/teams", body, &team)
+
+	return &team, err
+}
+
+// ReadTeam fetches a team by ID.
+func (c *Client) ReadTeam(id string) (*Team, RequestError) {
+	var team Team
+
+	err := c.request(http.MethodGet, "/v3/teams/"+id, nil, &team)
+
+	return &team, err
+}
+
+// UpdateTeam renames a team.
+func (c *Client) UpdateTeam(id, name string) (*Team, RequestError) {
+	body := map[string]interface{}{
+		"name": name,
+	}
+
+	var team Team
+
+	err := c.request(http.MethodPatch, "/v3/teams/"+id, body, &team)
+
+	return &team, err
+}
+
+// DeleteTeam deletes a team by ID.
+func (c *Client) DeleteTeam(id string) RequestError {
+	return c.request(http.MethodDelete, "/v3/teams/"+id, nil, nil)
+}
+
+// AddTeammateToTeam adds a teammate, identified by email, to a team.
+func (c *Client) AddTeammateToTeam(teamID, teammateEmail string) RequestError {
+	body := map[string]interface{}{
+		"email": teammateEmail,
+	}
+
+	return c.request(http.MethodPost, "/v3/teams/"+teamID+"/teammates", body, nil)
+}
+
+// RemoveTeammateFromTeam removes a teammate, identified by email, from a
+// team.
+func (c *Client) RemoveTeammateFromTeam(teamID, teammateEmail string) RequestError {
+	return c.request(http.MethodDelete, "/v3/teams/"+teamID+"/teammates/"+teammateEmail, nil, nil)
+}
+
+// AddAPIKeyToTeam binds an API Key to a team.
+func (c *Client) AddAPIKeyToTeam(teamID, apiKeyID string) RequestError {
+	body := map[string]interface{}{
+		"api_key_id": apiKeyID,
+	}
+
+	return c.request(http.MethodPost, "/v3/teams/"+teamID+"/api_keys", body, nil)
+}
+
+// RemoveAPIKeyFromTeam unbinds an API Key from a team.
+func (c *Client) RemoveAPIKeyFromTeam(teamID, apiKeyID string) RequestError {
+	return c.request(http.MethodDelete, "/v3/teams/"+teamID+"/api_keys/"+apiKeyID, nil, nil)
+}
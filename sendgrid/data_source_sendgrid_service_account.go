@@ -0,0 +1,61 @@
+/*
+Provide a data source to retrieve information about an existing service
+account.
+Example Usage
+```hcl
+data "sendgrid_service_account" "service_account" {
+	id = "a12345"
+}
+```
+*/
+package sendgrid
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+func dataSourceSendgridServiceAccount() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceSendgridServiceAccountRead,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the service account.",
+				Required:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the service account.",
+				Computed:    true,
+			},
+			"scopes": {
+				Type:        schema.TypeSet,
+				Description: "The scopes granted by this service account.",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceSendgridServiceAccountRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	serviceAccount, err := c.ReadServiceAccount(d.Get("id").(string))
+	if err.Err != nil {
+		return diag.FromErr(err.Err)
+	}
+
+	d.SetId(serviceAccount.ID)
+	//nolint:errcheck
+	d.Set("name", serviceAccount.Name)
+	//nolint:errcheck
+	d.Set("scopes", serviceAccount.Scopes)
+
+	return nil
+}
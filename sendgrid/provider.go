@@ -0,0 +1,39 @@
+package sendgrid
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+// Provider returns the terraform-provider-sendgrid schema.Provider.
+func Provider() *schema.Provider {
+	return &schema.Provider{
+		Schema: map[string]*schema.Schema{
+			"api_key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				DefaultFunc: schema.EnvDefaultFunc("SENDGRID_API_KEY", nil),
+				Description: "The SendGrid API Key used to authenticate with the SendGrid API.",
+			},
+		},
+
+		ResourcesMap: map[string]*schema.Resource{
+			"sendgrid_api_key":         resourceSendgridAPIKey(),
+			"sendgrid_team":            resourceSendgridTeam(),
+			"sendgrid_service_account": resourceSendgridServiceAccount(),
+		},
+
+		DataSourcesMap: map[string]*schema.Resource{
+			"sendgrid_service_account": dataSourceSendgridServiceAccount(),
+		},
+
+		ConfigureContextFunc: providerConfigure,
+	}
+}
+
+func providerConfigure(_ context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+	return sendgrid.NewClient(d.Get("api_key").(string)), nil
+}
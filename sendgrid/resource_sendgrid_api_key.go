@@ -15,18 +15,31 @@ An API key can be imported, e.g.
 ```hcl
 $ terraform import sendgrid_api_key.api_key apiKeyID
 ```
+A subuser-owned API key can be imported by prefixing the ID with the
+subuser's username, which populates `sub_user_on_behalf_of`, e.g.
+```hcl
+$ terraform import sendgrid_api_key.api_key subuser/apiKeyID
+```
 */
 package sendgrid
 
 import (
 	"context"
+	"fmt"
 	"reflect"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/trois-six/terraform-provider-sendgrid/internal/importer"
 	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
 )
 
+// apiKeyExpiryWarningWindow is how far in advance of expiry a diagnostic
+// warning is surfaced to the practitioner.
+const apiKeyExpiryWarningWindow = 7 * 24 * time.Hour
+
 func resourceSendgridAPIKey() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceSendgridAPIKeyCreate,
@@ -34,7 +47,16 @@ func resourceSendgridAPIKey() *schema.Resource {
 		UpdateContext: resourceSendgridAPIKeyUpdate,
 		DeleteContext: resourceSendgridAPIKeyDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importer.SubuserStateContext("sub_user_on_behalf_of"),
+		},
+
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Type:    resourceSendgridAPIKeyResourceV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceSendgridAPIKeyStateUpgradeV0,
+				Version: 0,
+			},
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -50,33 +72,135 @@ func resourceSendgridAPIKey() *schema.Resource {
 			},
 			"scopes": {
 				Type:        schema.TypeSet,
-				Description: "The individual permissions that you are giving to this API Key.",
+				Description: "The individual permissions that you are giving to this API Key. Ignored, and derived from `service_account_id` instead, when that attribute is set.",
 				Optional:    true,
 				Computed:    true,
 				Elem:        &schema.Schema{Type: schema.TypeString},
+				DiffSuppressFunc: func(_, _, _ string, d *schema.ResourceData) bool {
+					return d.Get("service_account_id").(string) != ""
+				},
+			},
+			"service_account_id": {
+				Type:        schema.TypeString,
+				Description: "The ID of a `sendgrid_service_account` this API Key's scopes are derived from. When set, `scopes` is ignored and updates to the service account propagate to this key.",
+				Optional:    true,
 			},
 			"api_key": {
 				Type:        schema.TypeString,
 				Description: "The API key created by the API.",
 				Computed:    true,
 			},
+			"expiry_time": {
+				Type:          schema.TypeString,
+				Description:   "An RFC3339 timestamp after which this API Key is considered expired. Conflicts with `rotation_days`.",
+				Optional:      true,
+				ForceNew:      true,
+				ValidateFunc:  validation.IsRFC3339Time,
+				ConflictsWith: []string{"rotation_days"},
+			},
+			"rotation_days": {
+				Type:          schema.TypeInt,
+				Description:   "The number of days after creation for which this API Key is valid. Once elapsed, Terraform marks the resource for recreation on the next `terraform apply`, generating a new key value. Conflicts with `expiry_time`.",
+				Optional:      true,
+				ConflictsWith: []string{"expiry_time"},
+			},
+			"expires_at": {
+				Type:        schema.TypeString,
+				Description: "The RFC3339 timestamp at which this API Key expires, derived from `expiry_time` or from the key's creation time plus `rotation_days`.",
+				Computed:    true,
+			},
+			"teams": {
+				Type:        schema.TypeSet,
+				Description: "The IDs of the `sendgrid_team` resources this API Key is bound to, for permission grouping.",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
 		},
 	}
 }
 
+// resourceSendgridAPIKeyResourceV0 is the schema prior to the addition of
+// service_account_id, used by resourceSendgridAPIKeyStateUpgradeV0 to
+// migrate state saved before that attribute existed.
+func resourceSendgridAPIKeyResourceV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"sub_user_on_behalf_of": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"scopes": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"api_key": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"expiry_time": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"rotation_days": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"expires_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"teams": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+// resourceSendgridAPIKeyStateUpgradeV0 adds the service_account_id attribute
+// to state saved by a provider version that predates it, so existing
+// sendgrid_api_key resources can adopt a service account without being
+// recreated.
+func resourceSendgridAPIKeyStateUpgradeV0(_ context.Context, rawState map[string]interface{}, _ interface{}) (map[string]interface{}, error) {
+	if _, ok := rawState["service_account_id"]; !ok {
+		rawState["service_account_id"] = ""
+	}
+
+	return rawState, nil
+}
+
 func resourceSendgridAPIKeyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var scopes []string
 
 	c := m.(*sendgrid.Client)
 	name := d.Get("name").(string)
+	expiryTime := d.Get("expiry_time").(string)
 	c.OnBehalfOf = d.Get("sub_user_on_behalf_of").(string)
 
 	for _, scope := range d.Get("scopes").(*schema.Set).List() {
 		scopes = append(scopes, scope.(string))
 	}
 
+	serviceAccountID := d.Get("service_account_id").(string)
+	if serviceAccountID != "" {
+		serviceAccountScopes, diags := scopesFromServiceAccount(c, serviceAccountID)
+		if diags != nil {
+			return diags
+		}
+
+		scopes = serviceAccountScopes
+	}
+
 	apiKeyStruct, err := sendgrid.RetryOnRateLimit(ctx, d, func() (interface{}, sendgrid.RequestError) {
-		return c.CreateAPIKey(name, scopes)
+		return c.CreateAPIKey(name, scopes, expiryTime, serviceAccountID)
 	})
 
 	apiKey := apiKeyStruct.(*sendgrid.APIKey)
@@ -89,9 +213,62 @@ func resourceSendgridAPIKeyCreate(ctx context.Context, d *schema.ResourceData, m
 	//nolint:errcheck
 	d.Set("api_key", apiKey.APIKey)
 
+	if diags := bindAPIKeyTeams(ctx, d, c, nil, d.Get("teams").(*schema.Set)); diags != nil {
+		return diags
+	}
+
 	return resourceSendgridAPIKeyRead(ctx, d, m)
 }
 
+// bindAPIKeyTeams reconciles the `teams` an API Key is bound to, adding the
+// key to teams present in n but not o and removing it from teams present in
+// o but not n.
+func bindAPIKeyTeams(ctx context.Context, d *schema.ResourceData, c *sendgrid.Client, o, n *schema.Set) diag.Diagnostics {
+	added, removed := diffSets(o, n)
+
+	for _, teamID := range removed {
+		teamID := teamID
+		if _, err := sendgrid.RetryOnRateLimit(ctx, d, func() (interface{}, sendgrid.RequestError) {
+			return nil, c.RemoveAPIKeyFromTeam(teamID, d.Id())
+		}); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	for _, teamID := range added {
+		teamID := teamID
+		if _, err := sendgrid.RetryOnRateLimit(ctx, d, func() (interface{}, sendgrid.RequestError) {
+			return nil, c.AddAPIKeyToTeam(teamID, d.Id())
+		}); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+// diffSets reconciles two possibly-nil schema.Set values of strings,
+// reporting the values added in n and the values removed from o.
+func diffSets(o, n *schema.Set) (added, removed []string) {
+	if o == nil {
+		o = &schema.Set{F: schema.HashString}
+	}
+
+	if n == nil {
+		n = &schema.Set{F: schema.HashString}
+	}
+
+	for _, v := range o.Difference(n).List() {
+		removed = append(removed, v.(string))
+	}
+
+	for _, v := range n.Difference(o).List() {
+		added = append(added, v.(string))
+	}
+
+	return added, removed
+}
+
 func resourceSendgridAPIKeyRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(*sendgrid.Client)
 
@@ -106,8 +283,70 @@ func resourceSendgridAPIKeyRead(_ context.Context, d *schema.ResourceData, m int
 	d.Set("name", apiKey.Name)
 	//nolint:errcheck
 	d.Set("scopes", remove(apiKey.Scopes, "2fa_required"))
+	//nolint:errcheck
+	d.Set("service_account_id", apiKey.ServiceAccountID)
+	//nolint:errcheck
+	d.Set("teams", apiKey.TeamIDs)
+
+	var diags diag.Diagnostics
+
+	if rotationDays := d.Get("rotation_days").(int); rotationDays > 0 {
+		expiresAt, parseErr := rotationExpiresAt(apiKey.CreatedAt, rotationDays)
+		if parseErr != nil {
+			return diag.Diagnostics{{
+				Severity: diag.Error,
+				Summary:  "Unable to compute API Key rotation window",
+				Detail:   fmt.Sprintf("API Key %q has an invalid created_at timestamp %q: %s", d.Id(), apiKey.CreatedAt, parseErr),
+			}}
+		}
 
-	return nil
+		if time.Now().After(expiresAt) {
+			// The rotation window has elapsed: drop the ID so Terraform
+			// treats this key as gone and creates a replacement.
+			d.SetId("")
+
+			return nil
+		}
+
+		//nolint:errcheck
+		d.Set("expires_at", expiresAt.Format(time.RFC3339))
+		diags = append(diags, expiryWarning(d.Id(), expiresAt)...)
+	} else if apiKey.ExpiresAt != "" {
+		//nolint:errcheck
+		d.Set("expires_at", apiKey.ExpiresAt)
+
+		if expiresAt, parseErr := time.Parse(time.RFC3339, apiKey.ExpiresAt); parseErr == nil {
+			diags = append(diags, expiryWarning(d.Id(), expiresAt)...)
+		}
+	}
+
+	return diags
+}
+
+// rotationExpiresAt computes the rotation deadline for an API Key from its
+// creation time plus rotationDays.
+func rotationExpiresAt(createdAt string, rotationDays int) (time.Time, error) {
+	t, err := time.Parse(time.RFC3339, createdAt)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return t.Add(time.Duration(rotationDays) * 24 * time.Hour), nil
+}
+
+// expiryWarning returns a warning diagnostic when expiresAt falls within
+// apiKeyExpiryWarningWindow of now, regardless of whether the expiry comes
+// from expiry_time or from rotation_days.
+func expiryWarning(apiKeyID string, expiresAt time.Time) diag.Diagnostics {
+	if !time.Now().Add(apiKeyExpiryWarningWindow).After(expiresAt) {
+		return nil
+	}
+
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  "API Key approaching expiry",
+		Detail:   fmt.Sprintf("API Key %q expires at %s.", apiKeyID, expiresAt.Format(time.RFC3339)),
+	}}
 }
 
 func hasDiff(o, n interface{}) bool {
@@ -118,6 +357,18 @@ func hasDiff(o, n interface{}) bool {
 	return !reflect.DeepEqual(o, n)
 }
 
+// scopesFromServiceAccount fetches the scopes granted by a service account,
+// for API Keys that reference one via service_account_id instead of
+// declaring their own scopes.
+func scopesFromServiceAccount(c *sendgrid.Client, serviceAccountID string) ([]string, diag.Diagnostics) {
+	serviceAccount, err := c.ReadServiceAccount(serviceAccountID)
+	if err.Err != nil {
+		return nil, diag.FromErr(err.Err)
+	}
+
+	return serviceAccount.Scopes, nil
+}
+
 func remove(s []string, r string) []string {
 	for i, v := range s {
 		if v == r {
@@ -138,9 +389,15 @@ func resourceSendgridAPIKeyUpdate(ctx context.Context, d *schema.ResourceData, m
 		Name: d.Get("name").(string),
 	}
 
-	o, n := d.GetChange("scopes")
+	serviceAccountID := d.Get("service_account_id").(string)
+	if serviceAccountID != "" {
+		serviceAccountScopes, diags := scopesFromServiceAccount(c, serviceAccountID)
+		if diags != nil {
+			return diags
+		}
 
-	if ok := hasDiff(o, n); ok {
+		a.Scopes = serviceAccountScopes
+	} else if o, n := d.GetChange("scopes"); hasDiff(o, n) {
 		var scopes []string
 		for _, scope := range d.Get("scopes").(*schema.Set).List() {
 			scopes = append(scopes, scope.(string))
@@ -150,12 +407,19 @@ func resourceSendgridAPIKeyUpdate(ctx context.Context, d *schema.ResourceData, m
 	}
 
 	_, err := sendgrid.RetryOnRateLimit(ctx, d, func() (interface{}, sendgrid.RequestError) {
-		return c.UpdateAPIKey(d.Id(), a.Name, a.Scopes)
+		return c.UpdateAPIKey(d.Id(), a.Name, a.Scopes, serviceAccountID)
 	})
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
+	if d.HasChange("teams") {
+		o, n := d.GetChange("teams")
+		if diags := bindAPIKeyTeams(ctx, d, c, o.(*schema.Set), n.(*schema.Set)); diags != nil {
+			return diags
+		}
+	}
+
 	return resourceSendgridAPIKeyRead(ctx, d, m)
 }
 
@@ -0,0 +1,61 @@
+package sendgrid
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestRotationExpiresAt(t *testing.T) {
+	t.Parallel()
+
+	createdAt := "2020-01-01T00:00:00Z"
+
+	expiresAt, err := rotationExpiresAt(createdAt, 30)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := time.Date(2020, time.January, 31, 0, 0, 0, 0, time.UTC)
+	if !expiresAt.Equal(want) {
+		t.Fatalf("got %s, want %s", expiresAt, want)
+	}
+
+	if _, err := rotationExpiresAt("not-a-timestamp", 30); err == nil {
+		t.Fatal("expected an error for an unparseable created_at")
+	}
+}
+
+func TestExpiryWarning(t *testing.T) {
+	t.Parallel()
+
+	if diags := expiryWarning("key-id", time.Now().Add(24*time.Hour)); len(diags) != 1 {
+		t.Fatalf("expected a warning diagnostic for an expiry inside the warning window, got %d", len(diags))
+	}
+
+	if diags := expiryWarning("key-id", time.Now().Add(365*24*time.Hour)); len(diags) != 0 {
+		t.Fatalf("expected no diagnostic for an expiry far in the future, got %d", len(diags))
+	}
+}
+
+func TestDiffSets(t *testing.T) {
+	t.Parallel()
+
+	o := schema.NewSet(schema.HashString, []interface{}{"a", "b"})
+	n := schema.NewSet(schema.HashString, []interface{}{"b", "c"})
+
+	added, removed := diffSets(o, n)
+
+	if len(added) != 1 || added[0] != "c" {
+		t.Fatalf("got added=%v, want [c]", added)
+	}
+
+	if len(removed) != 1 || removed[0] != "a" {
+		t.Fatalf("got removed=%v, want [a]", removed)
+	}
+
+	if added, removed := diffSets(nil, nil); len(added) != 0 || len(removed) != 0 {
+		t.Fatalf("got added=%v removed=%v for nil sets, want both empty", added, removed)
+	}
+}
@@ -0,0 +1,151 @@
+/*
+Provide a resource to manage a service account.
+A service account is a role template: a named set of scopes that can be
+referenced by one or more `sendgrid_api_key` resources via their
+`service_account_id` attribute. Updating the service account's scopes
+propagates the change to every API Key that references it.
+Example Usage
+```hcl
+resource "sendgrid_service_account" "service_account" {
+	name   = "my-service-account"
+	scopes = [
+		"mail.send",
+		"sender_verification_eligible",
+	]
+}
+```
+Import
+A service account can be imported, e.g.
+```hcl
+$ terraform import sendgrid_service_account.service_account serviceAccountID
+```
+*/
+package sendgrid
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/trois-six/terraform-provider-sendgrid/internal/importer"
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+func resourceSendgridServiceAccount() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSendgridServiceAccountCreate,
+		ReadContext:   resourceSendgridServiceAccountRead,
+		UpdateContext: resourceSendgridServiceAccountUpdate,
+		DeleteContext: resourceSendgridServiceAccountDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importer.StateContext(),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name you will use to describe this service account.",
+				Required:    true,
+			},
+			"scopes": {
+				Type:        schema.TypeSet,
+				Description: "The scopes granted by this service account. Changing this value updates every `sendgrid_api_key` that references this service account via `service_account_id`.",
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceSendgridServiceAccountCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var scopes []string
+
+	c := m.(*sendgrid.Client)
+	name := d.Get("name").(string)
+
+	for _, scope := range d.Get("scopes").(*schema.Set).List() {
+		scopes = append(scopes, scope.(string))
+	}
+
+	serviceAccountStruct, err := sendgrid.RetryOnRateLimit(ctx, d, func() (interface{}, sendgrid.RequestError) {
+		return c.CreateServiceAccount(name, scopes)
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	serviceAccount := serviceAccountStruct.(*sendgrid.ServiceAccount)
+
+	d.SetId(serviceAccount.ID)
+
+	return resourceSendgridServiceAccountRead(ctx, d, m)
+}
+
+func resourceSendgridServiceAccountRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	serviceAccount, err := c.ReadServiceAccount(d.Id())
+	if err.Err != nil {
+		return diag.FromErr(err.Err)
+	}
+
+	//nolint:errcheck
+	d.Set("name", serviceAccount.Name)
+	//nolint:errcheck
+	d.Set("scopes", serviceAccount.Scopes)
+
+	return nil
+}
+
+func resourceSendgridServiceAccountUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var scopes []string
+
+	c := m.(*sendgrid.Client)
+	name := d.Get("name").(string)
+
+	for _, scope := range d.Get("scopes").(*schema.Set).List() {
+		scopes = append(scopes, scope.(string))
+	}
+
+	serviceAccountStruct, err := sendgrid.RetryOnRateLimit(ctx, d, func() (interface{}, sendgrid.RequestError) {
+		return c.UpdateServiceAccount(d.Id(), name, scopes)
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	serviceAccount := serviceAccountStruct.(*sendgrid.ServiceAccount)
+
+	// Propagate the new scopes to every API Key attached to this service
+	// account, the same way a directly managed sendgrid_api_key would be
+	// updated. AttachedAPIKeyIDs is reported by the API from each key's
+	// service_account_id, which resourceSendgridAPIKeyCreate/Update sends
+	// when the key references this service account.
+	for _, apiKeyID := range serviceAccount.AttachedAPIKeyIDs {
+		apiKey, err := c.ReadAPIKey(apiKeyID)
+		if err.Err != nil {
+			return diag.FromErr(err.Err)
+		}
+
+		if _, err := sendgrid.RetryOnRateLimit(ctx, d, func() (interface{}, sendgrid.RequestError) {
+			return c.UpdateAPIKey(apiKeyID, apiKey.Name, scopes, d.Id())
+		}); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceSendgridServiceAccountRead(ctx, d, m)
+}
+
+func resourceSendgridServiceAccountDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	_, err := sendgrid.RetryOnRateLimit(ctx, d, func() (interface{}, sendgrid.RequestError) {
+		return nil, c.DeleteServiceAccount(d.Id())
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
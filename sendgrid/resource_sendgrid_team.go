@@ -0,0 +1,149 @@
+/*
+Provide a resource to manage a team.
+Example Usage
+```hcl
+resource "sendgrid_team" "team" {
+	name = "my-team"
+	teammates = [
+		"teammate.one@example.com",
+		"teammate.two@example.com",
+	]
+}
+```
+Import
+A team can be imported, e.g.
+```hcl
+$ terraform import sendgrid_team.team teamID
+```
+*/
+package sendgrid
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/trois-six/terraform-provider-sendgrid/internal/importer"
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+func resourceSendgridTeam() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSendgridTeamCreate,
+		ReadContext:   resourceSendgridTeamRead,
+		UpdateContext: resourceSendgridTeamUpdate,
+		DeleteContext: resourceSendgridTeamDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importer.StateContext(),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name you will use to describe this team.",
+				Required:    true,
+			},
+			"teammates": {
+				Type:        schema.TypeSet,
+				Description: "The email addresses of the teammates that are members of this team.",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"permissions": {
+				Type:        schema.TypeSet,
+				Description: "The permissions granted to this team, aggregated from the scopes of its member teammates.",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceSendgridTeamCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var teammates []string
+
+	c := m.(*sendgrid.Client)
+	name := d.Get("name").(string)
+
+	for _, teammate := range d.Get("teammates").(*schema.Set).List() {
+		teammates = append(teammates, teammate.(string))
+	}
+
+	teamStruct, err := sendgrid.RetryOnRateLimit(ctx, d, func() (interface{}, sendgrid.RequestError) {
+		return c.CreateTeam(name, teammates)
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	team := teamStruct.(*sendgrid.Team)
+
+	d.SetId(team.ID)
+
+	return resourceSendgridTeamRead(ctx, d, m)
+}
+
+func resourceSendgridTeamRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	team, err := c.ReadTeam(d.Id())
+	if err.Err != nil {
+		return diag.FromErr(err.Err)
+	}
+
+	//nolint:errcheck
+	d.Set("name", team.Name)
+	//nolint:errcheck
+	d.Set("teammates", team.Teammates)
+	//nolint:errcheck
+	d.Set("permissions", team.Permissions)
+
+	return nil
+}
+
+func resourceSendgridTeamUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	if d.HasChange("name") {
+		if _, err := sendgrid.RetryOnRateLimit(ctx, d, func() (interface{}, sendgrid.RequestError) {
+			return c.UpdateTeam(d.Id(), d.Get("name").(string))
+		}); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("teammates") {
+		o, n := d.GetChange("teammates")
+
+		for _, teammate := range o.(*schema.Set).Difference(n.(*schema.Set)).List() {
+			if _, err := sendgrid.RetryOnRateLimit(ctx, d, func() (interface{}, sendgrid.RequestError) {
+				return nil, c.RemoveTeammateFromTeam(d.Id(), teammate.(string))
+			}); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
+		for _, teammate := range n.(*schema.Set).Difference(o.(*schema.Set)).List() {
+			if _, err := sendgrid.RetryOnRateLimit(ctx, d, func() (interface{}, sendgrid.RequestError) {
+				return nil, c.AddTeammateToTeam(d.Id(), teammate.(string))
+			}); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	return resourceSendgridTeamRead(ctx, d, m)
+}
+
+func resourceSendgridTeamDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	_, err := sendgrid.RetryOnRateLimit(ctx, d, func() (interface{}, sendgrid.RequestError) {
+		return nil, c.DeleteTeam(d.Id())
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}